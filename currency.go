@@ -0,0 +1,35 @@
+package money
+
+// FormatOptions controls how a Money value is rendered by Format and parsed
+// by Parse.
+type FormatOptions struct {
+	Symbol       string // currency symbol, e.g. "$"
+	DecimalSep   string // decimal separator, e.g. "."
+	ThousandsSep string // grouping separator, e.g. ","
+	SymbolFirst  bool   // true to render the symbol before the amount
+	ShowCurrency bool   // true to append the ISO 4217 code
+	SpaceBetween bool   // true to insert a space between symbol and amount
+}
+
+// Currency describes an ISO 4217 currency: its code, the number of decimal
+// places its smallest unit represents, and its default formatting.
+type Currency struct {
+	Code          string
+	Scale         int
+	DefaultFormat FormatOptions
+}
+
+// Currencies is the registry of supported currencies, keyed by ISO 4217 code.
+var Currencies = map[string]Currency{
+	"USD": {Code: "USD", Scale: 2, DefaultFormat: FormatOptions{Symbol: "$", DecimalSep: ".", ThousandsSep: ",", SymbolFirst: true}},
+	"EUR": {Code: "EUR", Scale: 2, DefaultFormat: FormatOptions{Symbol: "€", DecimalSep: ",", ThousandsSep: ".", SymbolFirst: false}},
+	"GBP": {Code: "GBP", Scale: 2, DefaultFormat: FormatOptions{Symbol: "£", DecimalSep: ".", ThousandsSep: ",", SymbolFirst: true}},
+	"JPY": {Code: "JPY", Scale: 0, DefaultFormat: FormatOptions{Symbol: "¥", DecimalSep: ".", ThousandsSep: ",", SymbolFirst: true}},
+	"CHF": {Code: "CHF", Scale: 2, DefaultFormat: FormatOptions{Symbol: "CHF", DecimalSep: ".", ThousandsSep: "'", SymbolFirst: true, SpaceBetween: true}},
+	"CAD": {Code: "CAD", Scale: 2, DefaultFormat: FormatOptions{Symbol: "$", DecimalSep: ".", ThousandsSep: ",", SymbolFirst: true}},
+	"AUD": {Code: "AUD", Scale: 2, DefaultFormat: FormatOptions{Symbol: "$", DecimalSep: ".", ThousandsSep: ",", SymbolFirst: true}},
+	"CNY": {Code: "CNY", Scale: 2, DefaultFormat: FormatOptions{Symbol: "¥", DecimalSep: ".", ThousandsSep: ",", SymbolFirst: true}},
+	"INR": {Code: "INR", Scale: 2, DefaultFormat: FormatOptions{Symbol: "₹", DecimalSep: ".", ThousandsSep: ",", SymbolFirst: true}},
+	"SEK": {Code: "SEK", Scale: 2, DefaultFormat: FormatOptions{Symbol: "kr", DecimalSep: ",", ThousandsSep: ".", SymbolFirst: false, SpaceBetween: true}},
+	"BTC": {Code: "BTC", Scale: 8, DefaultFormat: FormatOptions{Symbol: "₿", DecimalSep: ".", ThousandsSep: ",", SymbolFirst: true}},
+}
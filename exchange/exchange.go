@@ -0,0 +1,93 @@
+// Package exchange converts Money values between currencies using
+// pluggable, exact-rational exchange rate providers.
+package exchange
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/Ceesaxp/money"
+)
+
+// ErrNoRate is returned when a provider has no rate for a currency pair
+// (directly, or via the Converter's base currency).
+var ErrNoRate = errors.New("no exchange rate available")
+
+// Exchanger supplies the exchange rate to multiply an amount in "from" by
+// to get an amount in "to", effective at the given time. Rates are exact
+// *big.Rat values so Converter never loses precision to a float64 factor.
+type Exchanger interface {
+	Rate(from, to string, at time.Time) (*big.Rat, error)
+}
+
+// Converter converts Money values using an Exchanger. When Base is set and
+// a provider has no direct rate for a pair, Converter triangulates through
+// it (from->Base, Base->to), so a provider only needs to carry N rates
+// against the base currency instead of N² pairs.
+type Converter struct {
+	Exchanger Exchanger
+	Base      string
+}
+
+// NewConverter creates a Converter backed by exchanger, triangulating
+// through base when a provider lacks a direct rate. base may be empty to
+// disable triangulation.
+func NewConverter(exchanger Exchanger, base string) *Converter {
+	return &Converter{Exchanger: exchanger, Base: strings.ToUpper(base)}
+}
+
+// Convert produces m's amount in the "to" currency at the current rate,
+// rounding according to mode.
+func (c *Converter) Convert(m *money.Money, to string, mode money.RoundingMode) (*money.Money, error) {
+	return c.ConvertAt(m, to, time.Now(), mode)
+}
+
+// ConvertAt produces m's amount in the "to" currency at the rate effective
+// at, rounding according to mode. This is how a TimeSeriesTable-backed
+// Converter reaches historical rates.
+func (c *Converter) ConvertAt(m *money.Money, to string, at time.Time, mode money.RoundingMode) (*money.Money, error) {
+	from := m.Currency()
+	to = strings.ToUpper(to)
+
+	if from == to {
+		// Return a copy, not m itself: Money's Scan/UnmarshalJSON/UnmarshalText
+		// mutate their receiver in place, and callers must be free to treat the
+		// result of a Convert/ConvertAt call as independent from the source.
+		return money.NewFromBigInt(m.BigInt(), m.Currency())
+	}
+
+	rate, err := c.rate(from, to, at)
+	if err != nil {
+		return nil, err
+	}
+	return m.MultiplyRat(rate, mode)
+}
+
+// rate resolves the from->to rate, falling back to triangulation through
+// c.Base when the exchanger has no direct rate for the pair.
+func (c *Converter) rate(from, to string, at time.Time) (*big.Rat, error) {
+	direct, directErr := c.Exchanger.Rate(from, to, at)
+	if directErr == nil {
+		return direct, nil
+	}
+	if c.Base == "" || from == c.Base || to == c.Base {
+		return nil, directErr
+	}
+
+	fromBase, err := c.Exchanger.Rate(from, c.Base, at)
+	if err != nil {
+		return nil, directErr
+	}
+	baseTo, err := c.Exchanger.Rate(c.Base, to, at)
+	if err != nil {
+		return nil, directErr
+	}
+	return new(big.Rat).Mul(fromBase, baseTo), nil
+}
+
+// pairKey normalizes a currency pair into a single lookup key.
+func pairKey(from, to string) string {
+	return strings.ToUpper(from) + "/" + strings.ToUpper(to)
+}
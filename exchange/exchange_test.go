@@ -0,0 +1,155 @@
+package exchange
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/Ceesaxp/money"
+)
+
+func TestConverter_Convert_DirectRate(t *testing.T) {
+	table := NewStaticTable()
+	table.SetRate("USD", "EUR", big.NewRat(9, 10)) // 1 USD = 0.9 EUR
+	converter := NewConverter(table, "")
+
+	m, err := money.NewFromSmallestUnit(10000, "USD") // $100.00
+	if err != nil {
+		t.Fatalf("NewFromSmallestUnit() error = %v", err)
+	}
+
+	got, err := converter.Convert(m, "EUR", money.RoundHalfUp)
+	if err != nil {
+		t.Fatalf("Convert() error = %v", err)
+	}
+	if got.SmallestUnit() != 9000 {
+		t.Errorf("Convert() = %v, want 9000", got.SmallestUnit())
+	}
+}
+
+func TestConverter_Convert_SameCurrencyReturnsCopy(t *testing.T) {
+	table := NewStaticTable()
+	converter := NewConverter(table, "")
+
+	original, err := money.NewFromSmallestUnit(10000, "USD")
+	if err != nil {
+		t.Fatalf("NewFromSmallestUnit() error = %v", err)
+	}
+
+	got, err := converter.Convert(original, "USD", money.RoundHalfUp)
+	if err != nil {
+		t.Fatalf("Convert() error = %v", err)
+	}
+
+	if err := got.Scan("1.00"); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if original.ExactString() != "100.00" {
+		t.Errorf("Convert() returned a value that aliases the source: original = %v after mutating the result, want unchanged 100.00", original.ExactString())
+	}
+}
+
+func TestConverter_Convert_Triangulates(t *testing.T) {
+	table := NewStaticTable()
+	table.SetRate("USD", "EUR", big.NewRat(9, 10))
+	table.SetRate("USD", "GBP", big.NewRat(8, 10))
+	converter := NewConverter(table, "USD")
+
+	m, err := money.NewFromSmallestUnit(10000, "EUR") // €100.00
+	if err != nil {
+		t.Fatalf("NewFromSmallestUnit() error = %v", err)
+	}
+
+	// EUR -> USD -> GBP: 100 EUR = (100/0.9) USD = (100/0.9)*0.8 GBP = 88.888... -> 88.89
+	got, err := converter.Convert(m, "GBP", money.RoundHalfUp)
+	if err != nil {
+		t.Fatalf("Convert() error = %v", err)
+	}
+	if got.SmallestUnit() != 8889 {
+		t.Errorf("Convert() = %v, want 8889", got.SmallestUnit())
+	}
+}
+
+func TestConverter_Convert_NoRate(t *testing.T) {
+	table := NewStaticTable()
+	converter := NewConverter(table, "")
+
+	m, _ := money.NewFromSmallestUnit(100, "USD")
+	if _, err := converter.Convert(m, "EUR", money.RoundHalfUp); err == nil {
+		t.Fatal("Convert() expected error, got nil")
+	}
+}
+
+func TestStaticTable_InverseRate(t *testing.T) {
+	table := NewStaticTable()
+	table.SetRate("USD", "EUR", big.NewRat(1, 2))
+
+	rate, err := table.Rate("EUR", "USD", time.Time{})
+	if err != nil {
+		t.Fatalf("Rate() error = %v", err)
+	}
+	if rate.Cmp(big.NewRat(2, 1)) != 0 {
+		t.Errorf("Rate() = %v, want 2/1", rate)
+	}
+}
+
+func TestTimeSeriesTable_EffectiveRate(t *testing.T) {
+	table := NewTimeSeriesTable()
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	mar := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	table.AddRate("USD", "EUR", jan, big.NewRat(9, 10))
+	table.AddRate("USD", "EUR", mar, big.NewRat(95, 100))
+
+	got, err := table.Rate("USD", "EUR", feb)
+	if err != nil {
+		t.Fatalf("Rate() error = %v", err)
+	}
+	if got.Cmp(big.NewRat(9, 10)) != 0 {
+		t.Errorf("Rate() at feb = %v, want 9/10 (the jan rate)", got)
+	}
+
+	got, err = table.Rate("USD", "EUR", mar.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Rate() error = %v", err)
+	}
+	if got.Cmp(big.NewRat(95, 100)) != 0 {
+		t.Errorf("Rate() after mar = %v, want 95/100", got)
+	}
+
+	if _, err := table.Rate("USD", "EUR", jan.Add(-time.Hour)); err == nil {
+		t.Error("Rate() before first observation: expected error, got nil")
+	}
+}
+
+func TestConverter_ConvertAt_HistoricalRate(t *testing.T) {
+	table := NewTimeSeriesTable()
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	mar := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	table.AddRate("USD", "EUR", jan, big.NewRat(9, 10))
+	table.AddRate("USD", "EUR", mar, big.NewRat(95, 100))
+	converter := NewConverter(table, "")
+
+	m, err := money.NewFromSmallestUnit(10000, "USD") // $100.00
+	if err != nil {
+		t.Fatalf("NewFromSmallestUnit() error = %v", err)
+	}
+
+	got, err := converter.ConvertAt(m, "EUR", jan, money.RoundHalfUp)
+	if err != nil {
+		t.Fatalf("ConvertAt() error = %v", err)
+	}
+	if got.SmallestUnit() != 9000 {
+		t.Errorf("ConvertAt() at jan = %v, want 9000 (the jan rate)", got.SmallestUnit())
+	}
+
+	got, err = converter.ConvertAt(m, "EUR", mar, money.RoundHalfUp)
+	if err != nil {
+		t.Fatalf("ConvertAt() error = %v", err)
+	}
+	if got.SmallestUnit() != 9500 {
+		t.Errorf("ConvertAt() at mar = %v, want 9500 (the mar rate)", got.SmallestUnit())
+	}
+}
@@ -0,0 +1,47 @@
+package exchange
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+)
+
+// StaticTable is an Exchanger backed by a fixed map of pair->rate, with no
+// notion of time. Safe for concurrent use and for tests that want
+// deterministic rates.
+type StaticTable struct {
+	mu    sync.RWMutex
+	rates map[string]*big.Rat
+}
+
+// NewStaticTable creates an empty StaticTable.
+func NewStaticTable() *StaticTable {
+	return &StaticTable{rates: make(map[string]*big.Rat)}
+}
+
+// SetRate records that one unit of from equals rate units of to.
+func (s *StaticTable) SetRate(from, to string, rate *big.Rat) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rates[pairKey(from, to)] = new(big.Rat).Set(rate)
+}
+
+// Rate implements Exchanger. The time argument is ignored. If only the
+// inverse pair was recorded, Rate returns its reciprocal.
+func (s *StaticTable) Rate(from, to string, _ time.Time) (*big.Rat, error) {
+	if from == to {
+		return big.NewRat(1, 1), nil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if r, ok := s.rates[pairKey(from, to)]; ok {
+		return new(big.Rat).Set(r), nil
+	}
+	if r, ok := s.rates[pairKey(to, from)]; ok {
+		return new(big.Rat).Inv(r), nil
+	}
+	return nil, fmt.Errorf("%w: %s/%s", ErrNoRate, from, to)
+}
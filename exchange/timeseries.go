@@ -0,0 +1,73 @@
+package exchange
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ratePoint is a single historical observation of a currency pair's rate.
+type ratePoint struct {
+	at   time.Time
+	rate *big.Rat
+}
+
+// TimeSeriesTable is an Exchanger backed by historical rates per currency
+// pair. Rate returns the rate effective at or before the requested time.
+// Safe for concurrent use.
+type TimeSeriesTable struct {
+	mu     sync.RWMutex
+	series map[string][]ratePoint // kept sorted ascending by at
+}
+
+// NewTimeSeriesTable creates an empty TimeSeriesTable.
+func NewTimeSeriesTable() *TimeSeriesTable {
+	return &TimeSeriesTable{series: make(map[string][]ratePoint)}
+}
+
+// AddRate records that, effective at, one unit of from equals rate units
+// of to.
+func (t *TimeSeriesTable) AddRate(from, to string, at time.Time, rate *big.Rat) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := pairKey(from, to)
+	points := t.series[key]
+	i := sort.Search(len(points), func(i int) bool { return points[i].at.After(at) })
+	points = append(points, ratePoint{})
+	copy(points[i+1:], points[i:])
+	points[i] = ratePoint{at: at, rate: new(big.Rat).Set(rate)}
+	t.series[key] = points
+}
+
+// Rate implements Exchanger, returning the most recent rate effective at
+// or before at. If only the inverse pair was recorded, Rate returns its
+// reciprocal.
+func (t *TimeSeriesTable) Rate(from, to string, at time.Time) (*big.Rat, error) {
+	if from == to {
+		return big.NewRat(1, 1), nil
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if r, ok := latestAtOrBefore(t.series[pairKey(from, to)], at); ok {
+		return r, nil
+	}
+	if r, ok := latestAtOrBefore(t.series[pairKey(to, from)], at); ok {
+		return new(big.Rat).Inv(r), nil
+	}
+	return nil, fmt.Errorf("%w: %s/%s at %s", ErrNoRate, from, to, at)
+}
+
+// latestAtOrBefore returns the most recent point at or before at, from a
+// slice sorted ascending by at.
+func latestAtOrBefore(points []ratePoint, at time.Time) (*big.Rat, bool) {
+	idx := sort.Search(len(points), func(i int) bool { return points[i].at.After(at) }) - 1
+	if idx < 0 {
+		return nil, false
+	}
+	return new(big.Rat).Set(points[idx].rate), true
+}
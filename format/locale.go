@@ -0,0 +1,51 @@
+// Package format implements a CLDR-style numeric pattern language for
+// rendering and parsing monetary amounts (patterns like "¤#,##0.00" or
+// "#,##0.00 ¤;(#,##0.00 ¤)"), together with a small registry of locales
+// that supply the symbols a pattern substitutes.
+package format
+
+import "sync"
+
+// Locale holds the symbols a pattern substitutes when formatting or
+// parsing: the decimal and grouping separators, the sign symbols, and the
+// placeholders for percent, currency, NaN and infinity.
+type Locale struct {
+	DecimalSep     string
+	GroupSep       string
+	MinusSign      string
+	PlusSign       string
+	PercentSign    string
+	CurrencySign   string
+	NaNSymbol      string
+	InfinitySymbol string
+}
+
+var (
+	localesMu sync.RWMutex
+	locales   = map[string]Locale{
+		"en-US": {DecimalSep: ".", GroupSep: ",", MinusSign: "-", PlusSign: "+", PercentSign: "%", CurrencySign: "$", NaNSymbol: "NaN", InfinitySymbol: "∞"},
+		"en-IN": {DecimalSep: ".", GroupSep: ",", MinusSign: "-", PlusSign: "+", PercentSign: "%", CurrencySign: "₹", NaNSymbol: "NaN", InfinitySymbol: "∞"},
+		"de-DE": {DecimalSep: ",", GroupSep: ".", MinusSign: "-", PlusSign: "+", PercentSign: "%", CurrencySign: "€", NaNSymbol: "NaN", InfinitySymbol: "∞"},
+		"fr-FR": {DecimalSep: ",", GroupSep: " ", MinusSign: "-", PlusSign: "+", PercentSign: "%", CurrencySign: "€", NaNSymbol: "NaN", InfinitySymbol: "∞"},
+		"ja-JP": {DecimalSep: ".", GroupSep: ",", MinusSign: "-", PlusSign: "+", PercentSign: "%", CurrencySign: "¥", NaNSymbol: "NaN", InfinitySymbol: "∞"},
+	}
+)
+
+// RegisterLocale adds or replaces a locale in the registry under name
+// (e.g. "pt-BR"), making it available to Lookup. Safe for concurrent use,
+// including concurrently with Lookup.
+func RegisterLocale(name string, l Locale) {
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	locales[name] = l
+}
+
+// Lookup returns the registered locale for name, and false if none is
+// registered. Safe for concurrent use, including concurrently with
+// RegisterLocale.
+func Lookup(name string) (Locale, bool) {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+	l, ok := locales[name]
+	return l, ok
+}
@@ -0,0 +1,305 @@
+package format
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+var (
+	// ErrInvalidPattern is returned when a pattern has no digit placeholders.
+	ErrInvalidPattern = errors.New("invalid format pattern")
+	// ErrParseAmount is returned when a formatted string doesn't match pattern.
+	ErrParseAmount = errors.New("error parsing formatted amount")
+)
+
+// subpattern is one half (positive or negative) of a compiled pattern.
+type subpattern struct {
+	prefix         string
+	suffix         string
+	minInt         int
+	minFrac        int
+	maxFrac        int
+	primaryGroup   int
+	secondaryGroup int
+}
+
+// compiledPattern is a parsed CLDR-style pattern, split on ';' into a
+// positive subpattern and an optional negative one (e.g. for accounting
+// parentheses).
+type compiledPattern struct {
+	positive subpattern
+	negative *subpattern
+}
+
+func isNumberChar(r byte) bool {
+	return r == '#' || r == '0' || r == ',' || r == '.'
+}
+
+func compileSubpattern(s string) (subpattern, error) {
+	start, end := -1, -1
+	for i := 0; i < len(s); i++ {
+		if isNumberChar(s[i]) {
+			if start == -1 {
+				start = i
+			}
+			end = i + 1
+		}
+	}
+	if start == -1 {
+		return subpattern{}, fmt.Errorf("%w: %q has no digit placeholders", ErrInvalidPattern, s)
+	}
+
+	prefix := s[:start]
+	suffix := s[end:]
+	numberPart := s[start:end]
+
+	intSpec, fracSpec := numberPart, ""
+	if dot := strings.IndexByte(numberPart, '.'); dot >= 0 {
+		intSpec, fracSpec = numberPart[:dot], numberPart[dot+1:]
+	}
+
+	minFrac, maxFrac := 0, len(fracSpec)
+	for i := 0; i < len(fracSpec); i++ {
+		if fracSpec[i] == '0' {
+			minFrac++
+		}
+	}
+
+	minInt := 0
+	for i := 0; i < len(intSpec); i++ {
+		if intSpec[i] == '0' {
+			minInt++
+		}
+	}
+	if minInt == 0 {
+		minInt = 1
+	}
+
+	groups := strings.Split(intSpec, ",")
+	var primaryGroup, secondaryGroup int
+	if len(groups) >= 2 {
+		primaryGroup = len(groups[len(groups)-1])
+	}
+	if len(groups) >= 3 {
+		secondaryGroup = len(groups[len(groups)-2])
+	}
+
+	return subpattern{
+		prefix:         prefix,
+		suffix:         suffix,
+		minInt:         minInt,
+		minFrac:        minFrac,
+		maxFrac:        maxFrac,
+		primaryGroup:   primaryGroup,
+		secondaryGroup: secondaryGroup,
+	}, nil
+}
+
+func compile(pattern string) (compiledPattern, error) {
+	parts := strings.SplitN(pattern, ";", 2)
+
+	pos, err := compileSubpattern(parts[0])
+	if err != nil {
+		return compiledPattern{}, err
+	}
+	cp := compiledPattern{positive: pos}
+
+	if len(parts) == 2 {
+		neg, err := compileSubpattern(parts[1])
+		if err != nil {
+			return compiledPattern{}, err
+		}
+		cp.negative = &neg
+	}
+	return cp, nil
+}
+
+func substituteSymbols(s string, locale Locale) string {
+	return strings.NewReplacer("¤", locale.CurrencySign, "%", locale.PercentSign).Replace(s)
+}
+
+// groupDigits inserts sep into intPart every primary digits from the right,
+// then every secondary digits beyond that (e.g. primary=3, secondary=2
+// renders Indian-style "12,34,567").
+func groupDigits(intPart string, primary, secondary int, sep string) string {
+	if sep == "" || primary <= 0 || len(intPart) <= primary {
+		return intPart
+	}
+	if secondary <= 0 {
+		secondary = primary
+	}
+
+	cut := len(intPart) - primary
+	groups := []string{intPart[cut:]}
+	remaining := intPart[:cut]
+
+	for len(remaining) > secondary {
+		cut = len(remaining) - secondary
+		groups = append(groups, remaining[cut:])
+		remaining = remaining[:cut]
+	}
+	if len(remaining) > 0 {
+		groups = append(groups, remaining)
+	}
+
+	for i, j := 0, len(groups)-1; i < j; i, j = i+1, j-1 {
+		groups[i], groups[j] = groups[j], groups[i]
+	}
+	return strings.Join(groups, sep)
+}
+
+// roundFrac rounds the digit string intPart+fracPart so that at most
+// maxFrac fractional digits remain, carrying into intPart as needed.
+func roundFrac(intPart, fracPart string, maxFrac int) (string, string) {
+	if len(fracPart) <= maxFrac {
+		return intPart, fracPart
+	}
+	if fracPart[maxFrac] < '5' {
+		return intPart, fracPart[:maxFrac]
+	}
+
+	combined := []byte(intPart + fracPart[:maxFrac])
+	i := len(combined) - 1
+	for i >= 0 {
+		if combined[i] == '9' {
+			combined[i] = '0'
+			i--
+			continue
+		}
+		combined[i]++
+		break
+	}
+	if i < 0 {
+		combined = append([]byte{'1'}, combined...)
+	}
+
+	newIntLen := len(combined) - maxFrac
+	return string(combined[:newIntLen]), string(combined[newIntLen:])
+}
+
+// Format renders amount (in the smallest currency unit, scale decimal
+// places) using locale's symbols and pattern's CLDR-style digit layout.
+func Format(amount *big.Int, scale int, locale Locale, pattern string) (string, error) {
+	cp, err := compile(pattern)
+	if err != nil {
+		return "", err
+	}
+
+	sub := cp.positive
+	neg := amount.Sign() < 0
+	signPrefix := ""
+	if neg {
+		if cp.negative != nil {
+			sub = *cp.negative
+		} else {
+			signPrefix = locale.MinusSign
+		}
+	}
+
+	digits := new(big.Int).Abs(amount).String()
+	for len(digits) <= scale {
+		digits = "0" + digits
+	}
+
+	var intPart, fracPart string
+	if scale > 0 {
+		intPart, fracPart = digits[:len(digits)-scale], digits[len(digits)-scale:]
+	} else {
+		intPart = digits
+	}
+
+	intPart, fracPart = roundFrac(intPart, fracPart, sub.maxFrac)
+	for len(fracPart) < sub.minFrac {
+		fracPart += "0"
+	}
+	for len(intPart) < sub.minInt {
+		intPart = "0" + intPart
+	}
+
+	var b strings.Builder
+	b.WriteString(signPrefix)
+	b.WriteString(substituteSymbols(sub.prefix, locale))
+	b.WriteString(groupDigits(intPart, sub.primaryGroup, sub.secondaryGroup, locale.GroupSep))
+	if sub.maxFrac > 0 {
+		b.WriteString(locale.DecimalSep)
+		b.WriteString(fracPart)
+	}
+	b.WriteString(substituteSymbols(sub.suffix, locale))
+	return b.String(), nil
+}
+
+// roundHalfUp rounds r to the nearest integer, ties away from zero.
+func roundHalfUp(r *big.Rat) *big.Int {
+	if r.IsInt() {
+		return new(big.Int).Set(r.Num())
+	}
+	neg := r.Sign() < 0
+	abs := new(big.Rat).Abs(r)
+	quo := new(big.Int).Quo(abs.Num(), abs.Denom())
+	rem := new(big.Rat).Sub(abs, new(big.Rat).SetInt(quo))
+	if rem.Cmp(big.NewRat(1, 2)) >= 0 {
+		quo.Add(quo, big.NewInt(1))
+	}
+	if neg {
+		quo.Neg(quo)
+	}
+	return quo
+}
+
+// Parse parses s, formatted per locale and pattern, into an exact
+// smallest-unit amount at the given scale. It accepts the negative
+// subpattern (e.g. accounting-style parentheses) when pattern defines one.
+func Parse(s string, scale int, locale Locale, pattern string) (*big.Int, error) {
+	cp, err := compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	trimmed := strings.TrimSpace(s)
+	neg := false
+	body := trimmed
+
+	if cp.negative != nil {
+		np := substituteSymbols(cp.negative.prefix, locale)
+		ns := substituteSymbols(cp.negative.suffix, locale)
+		if len(trimmed) >= len(np)+len(ns) && strings.HasPrefix(trimmed, np) && strings.HasSuffix(trimmed, ns) {
+			body = trimmed[len(np) : len(trimmed)-len(ns)]
+			neg = true
+		}
+	}
+
+	if !neg {
+		rest := trimmed
+		if locale.MinusSign != "" && strings.HasPrefix(rest, locale.MinusSign) {
+			neg = true
+			rest = rest[len(locale.MinusSign):]
+		}
+		pp := substituteSymbols(cp.positive.prefix, locale)
+		ps := substituteSymbols(cp.positive.suffix, locale)
+		if len(rest) < len(pp)+len(ps) || !strings.HasPrefix(rest, pp) || !strings.HasSuffix(rest, ps) {
+			return nil, fmt.Errorf("%w: %q", ErrParseAmount, s)
+		}
+		body = rest[len(pp) : len(rest)-len(ps)]
+	}
+
+	if locale.GroupSep != "" {
+		body = strings.ReplaceAll(body, locale.GroupSep, "")
+	}
+	if locale.DecimalSep != "" && locale.DecimalSep != "." {
+		body = strings.ReplaceAll(body, locale.DecimalSep, ".")
+	}
+
+	r, ok := new(big.Rat).SetString(body)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrParseAmount, s)
+	}
+	if neg {
+		r.Neg(r)
+	}
+
+	scaleFactor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(scaleFactor))
+	return roundHalfUp(scaled), nil
+}
@@ -0,0 +1,112 @@
+package format
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	usLocale, _ := Lookup("en-US")
+	inLocale, _ := Lookup("en-IN")
+	deLocale, _ := Lookup("de-DE")
+
+	tests := []struct {
+		name    string
+		amount  int64
+		scale   int
+		locale  Locale
+		pattern string
+		want    string
+	}{
+		{"simple currency-first", 123456, 2, usLocale, "¤#,##0.00", "$1,234.56"},
+		{"currency-last", 123456, 2, usLocale, "#,##0.00 ¤", "1,234.56 $"},
+		{"negative default sign", -123456, 2, usLocale, "¤#,##0.00", "-$1,234.56"},
+		{"negative parens", -123456, 2, usLocale, "¤#,##0.00;(¤#,##0.00)", "($1,234.56)"},
+		{"indian grouping", 1234567800, 2, inLocale, "¤#,##,##0.00", "₹1,23,45,678.00"},
+		{"german separators", 123456, 2, deLocale, "¤#,##0.00", "€1.234,56"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Format(big.NewInt(tt.amount), tt.scale, tt.locale, tt.pattern)
+			if err != nil {
+				t.Fatalf("Format() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	usLocale, _ := Lookup("en-US")
+
+	tests := []struct {
+		name    string
+		input   string
+		scale   int
+		locale  Locale
+		pattern string
+		want    int64
+		wantErr bool
+	}{
+		{"simple", "$1,234.56", 2, usLocale, "¤#,##0.00", 123456, false},
+		{"negative sign", "-$1,234.56", 2, usLocale, "¤#,##0.00", -123456, false},
+		{"accounting negative", "($1,234.56)", 2, usLocale, "¤#,##0.00;(¤#,##0.00)", -123456, false},
+		{"malformed", "nope", 2, usLocale, "¤#,##0.00", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.input, tt.scale, tt.locale, tt.pattern)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Parse() expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got.Cmp(big.NewInt(tt.want)) != 0 {
+				t.Errorf("Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegisterLocale(t *testing.T) {
+	RegisterLocale("pt-BR", Locale{DecimalSep: ",", GroupSep: ".", MinusSign: "-", CurrencySign: "R$"})
+
+	l, ok := Lookup("pt-BR")
+	if !ok {
+		t.Fatal("Lookup() did not find registered locale")
+	}
+
+	got, err := Format(big.NewInt(123456), 2, l, "¤#,##0.00")
+	if err != nil {
+		t.Fatalf("Format() error = %v", err)
+	}
+	if want := "R$1.234,56"; got != want {
+		t.Errorf("Format() = %q, want %q", got, want)
+	}
+}
+
+func TestRegisterLocale_ConcurrentWithLookup(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			RegisterLocale(fmt.Sprintf("xx-%d", i), Locale{DecimalSep: ".", GroupSep: ",", CurrencySign: "X"})
+		}(i)
+		go func() {
+			defer wg.Done()
+			Lookup("en-US")
+		}()
+	}
+	wg.Wait()
+}
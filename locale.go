@@ -0,0 +1,29 @@
+package money
+
+import (
+	"strings"
+
+	"github.com/Ceesaxp/money/format"
+)
+
+// FormatLocale renders the amount using a CLDR-style numeric pattern (e.g.
+// "¤#,##0.00" or the two-subpattern accounting form
+// "¤#,##0.00;(¤#,##0.00)") and the symbols supplied by locale.
+func (m *Money) FormatLocale(locale format.Locale, pattern string) (string, error) {
+	return format.Format(m.bigInt(), m.scale, locale, pattern)
+}
+
+// ParseLocale parses s, formatted per locale and pattern, into a Money
+// value in the given currency.
+func ParseLocale(s, currencyCode string, locale format.Locale, pattern string) (*Money, error) {
+	currency, ok := Currencies[strings.ToUpper(currencyCode)]
+	if !ok {
+		return nil, ErrInvalidCurrency
+	}
+
+	n, err := format.Parse(s, currency.Scale, locale, pattern)
+	if err != nil {
+		return nil, err
+	}
+	return NewFromBigInt(n, currency.Code)
+}
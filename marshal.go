@@ -0,0 +1,161 @@
+package money
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// jsonMoney mirrors the wire shape of Money: an exact decimal string paired
+// with an ISO 4217 currency code.
+type jsonMoney struct {
+	Amount   json.RawMessage `json:"amount"`
+	Currency string          `json:"currency"`
+}
+
+// MarshalJSON renders m as {"amount":"1234.56","currency":"USD"}, with
+// amount as the exact decimal string so no precision is lost through a
+// float round-trip.
+func (m *Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Amount   string `json:"amount"`
+		Currency string `json:"currency"`
+	}{
+		Amount:   m.ExactString(),
+		Currency: m.currency,
+	})
+}
+
+// UnmarshalJSON accepts the shape produced by MarshalJSON, with amount as
+// either a JSON string ("1234.56") or a JSON number (1234.56).
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var raw jsonMoney
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	amount := strings.Trim(string(raw.Amount), `"`)
+	parsed, err := NewFromString(amount, raw.Currency, RoundHalfUp)
+	if err != nil {
+		return err
+	}
+	*m = *parsed
+	return nil
+}
+
+// MarshalText renders m as "1234.56 USD".
+func (m *Money) MarshalText() ([]byte, error) {
+	return []byte(m.ExactString() + " " + m.currency), nil
+}
+
+// UnmarshalText parses the form produced by MarshalText.
+func (m *Money) UnmarshalText(text []byte) error {
+	fields := strings.Fields(string(text))
+	if len(fields) != 2 {
+		return fmt.Errorf("%w: %q", ErrParseAmount, text)
+	}
+
+	parsed, err := NewFromString(fields[0], fields[1], RoundHalfUp)
+	if err != nil {
+		return err
+	}
+	*m = *parsed
+	return nil
+}
+
+// Value implements database/sql/driver.Valuer, storing the amount as its
+// exact decimal string (a NUMERIC or TEXT column) rather than a float.
+// The currency is expected to live in a separate column.
+func (m Money) Value() (driver.Value, error) {
+	return m.ExactString(), nil
+}
+
+// Scan implements database/sql.Scanner. m must already carry the target
+// currency (e.g. via NewFromSmallestUnit(0, code)) since a single scanned
+// column cannot carry both amount and currency. It accepts []byte, string,
+// int64 (smallest-unit unit), and float64 sources.
+func (m *Money) Scan(src any) error {
+	if m.currency == "" {
+		return ErrInvalidCurrency
+	}
+
+	switch v := src.(type) {
+	case nil:
+		return ErrInvalidAmount
+	case []byte:
+		return m.scanString(string(v))
+	case string:
+		return m.scanString(v)
+	case int64:
+		parsed, err := NewFromBigInt(big.NewInt(v), m.currency)
+		if err != nil {
+			return err
+		}
+		*m = *parsed
+		return nil
+	case float64:
+		parsed, err := New(v, m.currency, RoundHalfUp)
+		if err != nil {
+			return err
+		}
+		*m = *parsed
+		return nil
+	default:
+		return fmt.Errorf("%w: unsupported Scan source %T", ErrInvalidAmount, src)
+	}
+}
+
+func (m *Money) scanString(s string) error {
+	parsed, err := NewFromString(s, m.currency, RoundHalfUp)
+	if err != nil {
+		return err
+	}
+	*m = *parsed
+	return nil
+}
+
+// ToUnitsNanos decomposes the amount into whole currency units and nanos
+// (billionths of a unit), following the google.type.Money / GNU Taler
+// convention: nanos is in [-999_999_999, 999_999_999] and shares units'
+// sign whenever both are non-zero.
+func (m *Money) ToUnitsNanos() (units int64, nanos int32) {
+	scaleDivisor := pow10(m.scale)
+	n := m.bigInt()
+
+	unitsBig := new(big.Int).Quo(n, scaleDivisor)
+	remainder := new(big.Int).Rem(n, scaleDivisor)
+
+	nanosBig := new(big.Int).Mul(remainder, big.NewInt(1_000_000_000))
+	nanosBig.Quo(nanosBig, scaleDivisor)
+
+	return unitsBig.Int64(), int32(nanosBig.Int64())
+}
+
+// NewFromUnitsNanos builds a Money from whole currency units and nanos
+// (billionths of a unit), per the google.type.Money / Taler convention.
+// nanos must be within [-999_999_999, 999_999_999] and must share units'
+// sign whenever both are non-zero.
+func NewFromUnitsNanos(units int64, nanos int32, currencyCode string) (*Money, error) {
+	if nanos <= -1_000_000_000 || nanos >= 1_000_000_000 {
+		return nil, ErrInvalidAmount
+	}
+	if (units > 0 && nanos < 0) || (units < 0 && nanos > 0) {
+		return nil, ErrInvalidAmount
+	}
+
+	currencyCode = strings.ToUpper(currencyCode)
+	currency, ok := Currencies[currencyCode]
+	if !ok {
+		return nil, ErrInvalidCurrency
+	}
+
+	scaleDivisor := pow10(currency.Scale)
+	unitsPart := new(big.Int).Mul(big.NewInt(units), scaleDivisor)
+
+	nanosRat := new(big.Rat).Mul(big.NewRat(int64(nanos), 1_000_000_000), new(big.Rat).SetInt(scaleDivisor))
+	nanosPart := roundBigRat(nanosRat, RoundHalfUp)
+
+	return NewFromBigInt(new(big.Int).Add(unitsPart, nanosPart), currency.Code)
+}
@@ -0,0 +1,132 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMoney_JSON(t *testing.T) {
+	m, err := NewFromSmallestUnit(123456, "USD")
+	if err != nil {
+		t.Fatalf("NewFromSmallestUnit() error = %v", err)
+	}
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+	if want := `{"amount":"1234.56","currency":"USD"}`; string(data) != want {
+		t.Errorf("MarshalJSON() = %s, want %s", data, want)
+	}
+
+	var got Money
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("UnmarshalJSON() error = %v", err)
+	}
+	if got.SmallestUnit() != m.SmallestUnit() || got.Currency() != m.Currency() {
+		t.Errorf("UnmarshalJSON() = %v, want %v", &got, m)
+	}
+
+	var fromNumber Money
+	if err := json.Unmarshal([]byte(`{"amount":1234.56,"currency":"USD"}`), &fromNumber); err != nil {
+		t.Fatalf("UnmarshalJSON() from number error = %v", err)
+	}
+	if fromNumber.SmallestUnit() != 123456 {
+		t.Errorf("UnmarshalJSON() from number = %v, want 123456", fromNumber.SmallestUnit())
+	}
+}
+
+func TestMoney_TextMarshaling(t *testing.T) {
+	m, err := NewFromSmallestUnit(123456, "USD")
+	if err != nil {
+		t.Fatalf("NewFromSmallestUnit() error = %v", err)
+	}
+
+	data, err := m.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText() error = %v", err)
+	}
+	if want := "1234.56 USD"; string(data) != want {
+		t.Errorf("MarshalText() = %s, want %s", data, want)
+	}
+
+	var got Money
+	if err := got.UnmarshalText(data); err != nil {
+		t.Fatalf("UnmarshalText() error = %v", err)
+	}
+	if got.SmallestUnit() != m.SmallestUnit() || got.Currency() != m.Currency() {
+		t.Errorf("UnmarshalText() = %v, want %v", &got, m)
+	}
+}
+
+func TestMoney_SQLValueScan(t *testing.T) {
+	m, err := NewFromSmallestUnit(123456, "USD")
+	if err != nil {
+		t.Fatalf("NewFromSmallestUnit() error = %v", err)
+	}
+
+	v, err := m.Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	if v != "1234.56" {
+		t.Errorf("Value() = %v, want 1234.56", v)
+	}
+
+	tests := []struct {
+		name string
+		src  any
+		want int64
+	}{
+		{"string", "1234.56", 123456},
+		{"[]byte", []byte("1234.56"), 123456},
+		{"int64", int64(42), 42},
+		{"float64", 1234.56, 123456},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dst, _ := NewFromSmallestUnit(0, "USD")
+			if err := dst.Scan(tt.src); err != nil {
+				t.Fatalf("Scan() error = %v", err)
+			}
+			if dst.SmallestUnit() != tt.want {
+				t.Errorf("Scan() = %v, want %v", dst.SmallestUnit(), tt.want)
+			}
+		})
+	}
+
+	var noCurrency Money
+	if err := noCurrency.Scan("1.00"); err != ErrInvalidCurrency {
+		t.Errorf("Scan() error = %v, want ErrInvalidCurrency", err)
+	}
+}
+
+func TestMoney_ToUnitsNanos(t *testing.T) {
+	m, err := NewFromSmallestUnit(123456, "USD") // $1234.56
+	if err != nil {
+		t.Fatalf("NewFromSmallestUnit() error = %v", err)
+	}
+
+	units, nanos := m.ToUnitsNanos()
+	if units != 1234 || nanos != 560000000 {
+		t.Errorf("ToUnitsNanos() = (%v, %v), want (1234, 560000000)", units, nanos)
+	}
+
+	roundTripped, err := NewFromUnitsNanos(units, nanos, "USD")
+	if err != nil {
+		t.Fatalf("NewFromUnitsNanos() error = %v", err)
+	}
+	if roundTripped.SmallestUnit() != m.SmallestUnit() {
+		t.Errorf("NewFromUnitsNanos() = %v, want %v", roundTripped.SmallestUnit(), m.SmallestUnit())
+	}
+}
+
+func TestNewFromUnitsNanos_InvalidSign(t *testing.T) {
+	if _, err := NewFromUnitsNanos(1, -500000000, "USD"); err != ErrInvalidAmount {
+		t.Errorf("NewFromUnitsNanos() error = %v, want ErrInvalidAmount", err)
+	}
+	if _, err := NewFromUnitsNanos(0, 1_000_000_000, "USD"); err != ErrInvalidAmount {
+		t.Errorf("NewFromUnitsNanos() error = %v, want ErrInvalidAmount", err)
+	}
+}
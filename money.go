@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/big"
 	"regexp"
 	"strconv"
 	"strings"
@@ -13,19 +14,44 @@ import (
 type RoundingMode int
 
 const (
+	// RoundHalfUp rounds ties toward positive infinity: +0.5 -> 1, -0.5 -> 0.
 	RoundHalfUp RoundingMode = iota
+	// RoundHalfDown rounds ties toward negative infinity: +0.5 -> 0, -0.5 -> -1.
 	RoundHalfDown
+	// RoundUp rounds away from zero, e.g. 1.1 -> 2, -1.1 -> -2.
 	RoundUp
+	// RoundDown truncates toward zero, e.g. 1.9 -> 1, -1.9 -> -1.
 	RoundDown
-	RoundHalfEven // Also known as "banker's rounding"
+	// RoundHalfEven rounds ties to the nearest even neighbor ("banker's rounding").
+	RoundHalfEven
+	// RoundHalfOdd rounds ties to the nearest odd neighbor.
+	RoundHalfOdd
+	// RoundHalfTowardZero rounds ties toward zero: +0.5 -> 0, -0.5 -> 0.
+	RoundHalfTowardZero
+	// RoundHalfAwayFromZero rounds ties away from zero: +0.5 -> 1, -0.5 -> -1.
+	RoundHalfAwayFromZero
+	// RoundCeiling always rounds toward positive infinity.
+	RoundCeiling
+	// RoundFloor always rounds toward negative infinity.
+	RoundFloor
+	// Round05Up truncates toward zero, except when the truncated value ends
+	// in 0 or 5, in which case it rounds away from zero. Used for cash
+	// rounding schemes (e.g. pre-2010 Swedish öre rounding).
+	Round05Up
 )
 
-// Money represents a monetary amount in the smallest currency unit (e.g., cents)
+// Money represents a monetary amount in the smallest currency unit (e.g., cents).
+//
+// Amounts that fit in an int64 are stored in amount directly, however they
+// were constructed. Amounts that don't are stored in big instead, and
+// amount is left at zero.
+// Use bigInt to read either representation uniformly.
 type Money struct {
-	amount   int64  // Store amount in smallest currency unit
-	currency string // ISO 4217 currency code
-	scale    int    // Number of decimal places
-	divisor  int64  // Divisor for converting to decimal
+	amount   int64    // smallest-unit amount, valid when big == nil
+	big      *big.Int // smallest-unit amount, set only once amount overflows int64
+	currency string   // ISO 4217 currency code
+	scale    int      // Number of decimal places
+	divisor  int64    // Divisor for converting to decimal
 }
 
 var (
@@ -35,43 +61,154 @@ var (
 	ErrInvalidFactor                     = errors.New("invalid factor")
 	ErrInvalidDivisor                    = errors.New("invalid divisor")
 	ErrInvalidSplitParts                 = errors.New("invalid number of split parts")
+	ErrInvalidAllocation                 = errors.New("invalid allocation ratios")
 	ErrCannotDealWithDifferentCurrencies = errors.New("cannot deal with different currencies")
 	ErrParseAmount                       = errors.New("error parsing amount")
 
 	numberRegex = regexp.MustCompile(`^-?\d*\.?\d+$`)
 )
 
-// round applies the specified rounding mode to a float64
+// round applies the specified rounding mode to a float64 amount, scaling it
+// by 10^scale. amount is first snapped to its shortest round-tripping decimal
+// string (the same way NewFromString parses user input) rather than read as
+// the raw binary value: a caller who writes New(1.9, ...) means the decimal
+// 1.9, not the float64 bit pattern nearest to it, and taking the latter as
+// ground truth would make RoundDown/RoundUp/RoundCeiling/RoundFloor wrong
+// for ordinary decimal literals. Scaling and rounding then happen in exact
+// integer/decimal arithmetic with no further float multiplication to
+// introduce noise.
 func round(amount float64, scale int, mode RoundingMode) int64 {
-	multiplier := math.Pow10(scale)
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(amount, 'g', -1, 64))
+	if !ok {
+		r = new(big.Rat)
+	}
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(pow10(scale)))
+	return roundBigRat(scaled, mode).Int64()
+}
+
+// roundBigRat rounds r to the nearest *big.Int according to mode, without
+// ever going through float64.
+func roundBigRat(r *big.Rat, mode RoundingMode) *big.Int {
+	if r.IsInt() {
+		return new(big.Int).Set(r.Num())
+	}
+
+	neg := r.Sign() < 0
+	abs := new(big.Rat).Abs(r)
+	floor := new(big.Int).Quo(abs.Num(), abs.Denom()) // truncated toward zero; abs is non-negative so this is floor(|r|)
+	ceil := new(big.Int).Add(floor, big.NewInt(1))    // ceil(|r|)
+	rem := new(big.Rat).Sub(abs, new(big.Rat).SetInt(floor))
+	half := big.NewRat(1, 2)
+
+	away := func() *big.Int { return ceil }    // magnitude grows
+	toward := func() *big.Int { return floor } // magnitude shrinks
+
+	var result *big.Int
 	switch mode {
-	case RoundHalfUp:
-		return int64(math.Round(amount * multiplier))
-	case RoundHalfDown:
-		return int64(amount*multiplier + 0.5 - 0.00001)
 	case RoundUp:
-		return int64(math.Ceil(amount * multiplier))
+		result = away()
 	case RoundDown:
-		return int64(math.Floor(amount * multiplier))
+		result = toward()
+	case RoundCeiling:
+		if neg {
+			result = toward()
+		} else {
+			result = away()
+		}
+	case RoundFloor:
+		if neg {
+			result = away()
+		} else {
+			result = toward()
+		}
+	case RoundHalfDown: // ties toward negative infinity
+		cmp := rem.Cmp(half)
+		if cmp > 0 || (cmp == 0 && neg) {
+			result = away()
+		} else {
+			result = toward()
+		}
+	case RoundHalfAwayFromZero:
+		if rem.Cmp(half) >= 0 {
+			result = away()
+		} else {
+			result = toward()
+		}
+	case RoundHalfTowardZero:
+		if rem.Cmp(half) > 0 {
+			result = away()
+		} else {
+			result = toward()
+		}
 	case RoundHalfEven:
-		scaled := amount * multiplier
-		_, frac := math.Modf(scaled)
-		if math.Abs(frac) == 0.5 {
-			if int64(scaled)%2 == 0 {
-				return int64(math.Floor(scaled))
-			}
-			return int64(math.Ceil(scaled))
+		cmp := rem.Cmp(half)
+		if cmp > 0 || (cmp == 0 && floor.Bit(0) == 1) {
+			result = away()
+		} else {
+			result = toward()
+		}
+	case RoundHalfOdd:
+		cmp := rem.Cmp(half)
+		if cmp > 0 || (cmp == 0 && floor.Bit(0) == 0) {
+			result = away()
+		} else {
+			result = toward()
 		}
-		return int64(math.Round(scaled))
+	case Round05Up:
+		if rem.Sign() == 0 {
+			result = floor
+		} else if last := new(big.Int).Mod(floor, big.NewInt(10)).Int64(); last == 0 || last == 5 {
+			result = away()
+		} else {
+			result = toward()
+		}
+	case RoundHalfUp: // ties toward positive infinity
+		fallthrough
 	default:
-		return int64(math.Round(amount * multiplier))
+		cmp := rem.Cmp(half)
+		if cmp > 0 || (cmp == 0 && !neg) {
+			result = away()
+		} else {
+			result = toward()
+		}
+	}
+
+	if neg {
+		result = new(big.Int).Neg(result)
 	}
+	return result
+}
+
+// pow10 returns 10^n as a *big.Int.
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// addOverflowsInt64 reports whether a+b overflows an int64.
+func addOverflowsInt64(a, b int64) bool {
+	c := a + b
+	return ((a ^ c) & (b ^ c)) < 0
+}
+
+// subOverflowsInt64 reports whether a-b overflows an int64.
+func subOverflowsInt64(a, b int64) bool {
+	c := a - b
+	return ((a ^ b) & (a ^ c)) < 0
+}
+
+// bigInt returns the smallest-unit amount as a *big.Int, reading from
+// whichever of amount/big currently holds the value.
+func (m *Money) bigInt() *big.Int {
+	if m.big != nil {
+		return m.big
+	}
+	return big.NewInt(m.amount)
 }
 
 // New creates a new Money instance from a decimal amount
 func New(amount float64, currencyCode string, mode RoundingMode) (*Money, error) {
 	if math.IsNaN(amount) || math.IsInf(amount, 0) {
-		return nil, errors.New("invalid amount")
+		return nil, ErrInvalidAmount
 	}
 
 	// Upper-case currency code
@@ -83,7 +220,7 @@ func New(amount float64, currencyCode string, mode RoundingMode) (*Money, error)
 	}
 
 	// Convert to cents (or smallest currency unit)
-	cents := round(amount*math.Pow10(currency.Scale), currency.Scale, mode)
+	cents := round(amount, currency.Scale, mode)
 
 	return &Money{
 		amount:   cents,
@@ -108,16 +245,98 @@ func NewFromSmallestUnit(cents int64, currencyCode string) (*Money, error) {
 	}, nil
 }
 
-// Amount returns the decimal representation of the monetary amount
+// NewFromBigInt creates a new Money instance from an amount in the smallest
+// currency unit expressed as an arbitrary-precision integer, for amounts
+// that don't fit in an int64 (e.g. satoshi totals, high-inflation currency
+// ledgers).
+func NewFromBigInt(n *big.Int, currencyCode string) (*Money, error) {
+	currencyCode = strings.ToUpper(currencyCode)
+	currency, ok := Currencies[currencyCode]
+	if !ok {
+		return nil, ErrInvalidCurrency
+	}
+
+	m := &Money{
+		currency: currency.Code,
+		scale:    currency.Scale,
+		divisor:  int64(math.Pow10(currency.Scale)),
+	}
+	if n.IsInt64() {
+		m.amount = n.Int64()
+	} else {
+		m.big = new(big.Int).Set(n)
+	}
+	return m, nil
+}
+
+// NewFromString creates a new Money instance from an exact decimal string
+// (e.g. "1234.56" or "-0.001"), scaling and rounding it to the currency's
+// smallest unit without ever passing through float64.
+func NewFromString(s, currencyCode string, mode RoundingMode) (*Money, error) {
+	currencyCode = strings.ToUpper(currencyCode)
+	currency, ok := Currencies[currencyCode]
+	if !ok {
+		return nil, ErrInvalidCurrency
+	}
+
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrParseAmount, s)
+	}
+
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(pow10(currency.Scale)))
+	return NewFromBigInt(roundBigRat(scaled, mode), currency.Code)
+}
+
+// Amount returns the decimal representation of the monetary amount.
+//
+// This conversion is lossy for amounts that don't fit exactly in a
+// float64; use ExactString for a precision-preserving representation.
 func (m *Money) Amount() float64 {
+	if m.big != nil {
+		f, _ := new(big.Rat).SetFrac(m.big, pow10(m.scale)).Float64()
+		return f
+	}
 	return float64(m.amount) / float64(m.divisor)
 }
 
-// Cents returns the amount in cents
+// SmallestUnit returns the amount in the currency's smallest unit (e.g.
+// cents). If the amount overflowed int64 (see BigInt), this returns 0;
+// callers that may be dealing with such amounts should use BigInt instead.
 func (m *Money) SmallestUnit() int64 {
 	return m.amount
 }
 
+// BigInt returns the smallest-unit amount as an arbitrary-precision integer.
+// The returned value is a copy and safe for the caller to mutate.
+func (m *Money) BigInt() *big.Int {
+	return new(big.Int).Set(m.bigInt())
+}
+
+// ExactString returns the exact base-10 decimal representation of the
+// amount (e.g. "1234.56"), with no precision loss regardless of magnitude.
+func (m *Money) ExactString() string {
+	n := m.bigInt()
+	neg := n.Sign() < 0
+	digits := new(big.Int).Abs(n).String()
+	scale := m.scale
+
+	for len(digits) <= scale {
+		digits = "0" + digits
+	}
+
+	sign := ""
+	if neg {
+		sign = "-"
+	}
+	if scale == 0 {
+		return sign + digits
+	}
+
+	split := len(digits) - scale
+	return sign + digits[:split] + "." + digits[split:]
+}
+
 // Currency returns the currency code
 func (m *Money) Currency() string {
 	return m.currency
@@ -125,9 +344,7 @@ func (m *Money) Currency() string {
 
 // String returns a formatted string representation
 func (m *Money) String() string {
-	amount := float64(m.amount) / float64(m.divisor)
-	formatString := fmt.Sprintf("%%.%df %%s", m.scale)
-	return fmt.Sprintf(formatString, amount, m.currency)
+	return fmt.Sprintf("%s %s", m.ExactString(), m.currency)
 }
 
 // Add adds two monetary amounts of the same currency
@@ -136,11 +353,12 @@ func (m *Money) Add(other *Money) (*Money, error) {
 		return nil, ErrCannotDealWithDifferentCurrencies
 	}
 
-	amount, err := NewFromSmallestUnit(m.amount+other.amount, m.currency)
-	if err != nil {
-		return nil, err
+	if m.big == nil && other.big == nil && !addOverflowsInt64(m.amount, other.amount) {
+		return NewFromSmallestUnit(m.amount+other.amount, m.currency)
 	}
-	return amount, nil
+
+	sum := new(big.Int).Add(m.bigInt(), other.bigInt())
+	return NewFromBigInt(sum, m.currency)
 }
 
 // Subtract subtracts two monetary amounts of the same currency
@@ -149,11 +367,12 @@ func (m *Money) Subtract(other *Money) (*Money, error) {
 		return nil, ErrCannotDealWithDifferentCurrencies
 	}
 
-	amount, err := NewFromSmallestUnit(m.amount-other.amount, m.currency)
-	if err != nil {
-		return nil, err
+	if m.big == nil && other.big == nil && !subOverflowsInt64(m.amount, other.amount) {
+		return NewFromSmallestUnit(m.amount-other.amount, m.currency)
 	}
-	return amount, nil
+
+	diff := new(big.Int).Sub(m.bigInt(), other.bigInt())
+	return NewFromBigInt(diff, m.currency)
 }
 
 // Multiply multiplies the monetary amount by a factor
@@ -162,26 +381,49 @@ func (m *Money) Multiply(factor float64, mode RoundingMode) (*Money, error) {
 		return nil, ErrInvalidFactor
 	}
 
-	newAmount := round(float64(m.amount)*factor, m.scale, mode)
-	amount, err := NewFromSmallestUnit(newAmount, m.currency)
-	if err != nil {
-		return nil, err
+	r := new(big.Rat).SetFloat64(factor)
+	if r == nil {
+		return nil, ErrInvalidFactor
+	}
+	return m.MultiplyRat(r, mode)
+}
+
+// MultiplyRat multiplies the monetary amount by an exact rational factor,
+// avoiding the precision loss a float64 factor would introduce.
+func (m *Money) MultiplyRat(factor *big.Rat, mode RoundingMode) (*Money, error) {
+	if factor == nil {
+		return nil, ErrInvalidFactor
 	}
-	return amount, nil
+
+	product := new(big.Rat).Mul(new(big.Rat).SetInt(m.bigInt()), factor)
+	return NewFromBigInt(roundBigRat(product, mode), m.currency)
 }
 
-// Divide divides the monetary amount by a factor
+// Divide divides the monetary amount by a factor, rounding exact .5 remainders
+// away from zero (matching the historical behavior of this method, which was
+// built on math.Round before the big.Int backend existed). Use DivideRat
+// directly for control over the rounding mode.
 func (m *Money) Divide(divisor float64) (*Money, error) {
-	if math.IsNaN(divisor) || math.IsInf(divisor, 0) {
+	if math.IsNaN(divisor) || math.IsInf(divisor, 0) || divisor == 0 {
 		return nil, ErrInvalidDivisor
 	}
 
-	newAmount := int64(math.Round(float64(m.amount) / divisor))
-	amount, err := NewFromSmallestUnit(newAmount, m.currency)
-	if err != nil {
-		return nil, err
+	r := new(big.Rat).SetFloat64(divisor)
+	if r == nil {
+		return nil, ErrInvalidDivisor
 	}
-	return amount, nil
+	return m.DivideRat(r, RoundHalfAwayFromZero)
+}
+
+// DivideRat divides the monetary amount by an exact rational divisor,
+// rounding the result according to mode.
+func (m *Money) DivideRat(divisor *big.Rat, mode RoundingMode) (*Money, error) {
+	if divisor == nil || divisor.Sign() == 0 {
+		return nil, ErrInvalidDivisor
+	}
+
+	quotient := new(big.Rat).Quo(new(big.Rat).SetInt(m.bigInt()), divisor)
+	return NewFromBigInt(roundBigRat(quotient, mode), m.currency)
 }
 
 // Split divides an amount into n equal parts
@@ -190,26 +432,131 @@ func (m *Money) Split(n int) ([]*Money, error) {
 		return nil, ErrInvalidSplitParts
 	}
 
-	// Calculate the base amount for each part
-	baseAmount := m.amount / int64(n)
-	remainder := m.amount % int64(n)
+	total := m.bigInt()
+	nBig := big.NewInt(int64(n))
+
+	baseAmount := new(big.Int).Quo(total, nBig)
+	remainder := new(big.Int).Rem(total, nBig)
+	remN := remainder.Int64() // |remainder| < n, always representable
+	unit := big.NewInt(1)
+	if remN < 0 {
+		remN = -remN
+		unit = big.NewInt(-1)
+	}
 
 	results := make([]*Money, n)
 	for i := 0; i < n; i++ {
-		amount := baseAmount
-		if int64(i) < remainder {
-			amount++
+		share := new(big.Int).Set(baseAmount)
+		if int64(i) < remN {
+			share.Add(share, unit)
 		}
-		results[i] = &Money{
-			amount:   amount,
-			currency: m.currency,
-			scale:    m.scale,
+		part, err := NewFromBigInt(share, m.currency)
+		if err != nil {
+			return nil, err
 		}
+		results[i] = part
 	}
 
 	return results, nil
 }
 
+// Allocate distributes the amount according to ratios, a set of integer
+// weights, without losing or creating a smallest unit: sum(result) always
+// equals the original amount exactly. Each share is amount*ratio/total
+// truncated toward zero; the leftover smallest units (at most
+// len(ratios)-1 of them) go one at a time to the earliest ratios, so e.g. a $1.00 invoice split 70/20/10
+// allocates as 70c/20c/10c and a $0.01 invoice split 1/1/1 allocates as
+// 1c/0c/0c.
+func (m *Money) Allocate(ratios []int64) ([]*Money, error) {
+	if len(ratios) == 0 {
+		return nil, ErrInvalidAllocation
+	}
+
+	total := big.NewInt(0)
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, ErrInvalidAllocation
+		}
+		total.Add(total, big.NewInt(ratio))
+	}
+	if total.Sign() == 0 {
+		return nil, ErrInvalidAllocation
+	}
+
+	amount := m.bigInt()
+	shares := make([]*big.Int, len(ratios))
+	distributed := big.NewInt(0)
+	for i, ratio := range ratios {
+		share := new(big.Int).Mul(amount, big.NewInt(ratio))
+		share.Quo(share, total)
+		shares[i] = share
+		distributed.Add(distributed, share)
+	}
+
+	remainder := new(big.Int).Sub(amount, distributed)
+	unit := big.NewInt(1)
+	if remainder.Sign() < 0 {
+		unit = big.NewInt(-1)
+	}
+	for i := 0; remainder.Sign() != 0; i = (i + 1) % len(shares) {
+		shares[i].Add(shares[i], unit)
+		remainder.Sub(remainder, unit)
+	}
+
+	results := make([]*Money, len(ratios))
+	for i, share := range shares {
+		part, err := NewFromBigInt(share, m.currency)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = part
+	}
+	return results, nil
+}
+
+// AllocateFloat is a convenience wrapper around Allocate for callers with
+// float64 weights (e.g. 0.7, 0.2, 0.1); the floats are scaled up to
+// integer ratios before allocating.
+func (m *Money) AllocateFloat(ratios []float64) ([]*Money, error) {
+	if len(ratios) == 0 {
+		return nil, ErrInvalidAllocation
+	}
+
+	const scale = 1_000_000
+	intRatios := make([]int64, len(ratios))
+	for i, r := range ratios {
+		if math.IsNaN(r) || math.IsInf(r, 0) || r < 0 {
+			return nil, ErrInvalidAllocation
+		}
+		intRatios[i] = int64(math.Round(r * scale))
+	}
+	return m.Allocate(intRatios)
+}
+
+// RoundToIncrement rounds the amount to the nearest multiple of increment
+// smallest units, using mode to resolve non-exact multiples (and ties). This
+// covers cash rounding schemes like Swiss 5-rappen rounding
+// (increment=5, RoundHalfEven) or rounding to steps of ten smallest units,
+// e.g. 10 rappen (increment=10). A non-positive increment is treated as 1
+// (a no-op).
+func (m *Money) RoundToIncrement(increment int64, mode RoundingMode) *Money {
+	if increment <= 0 {
+		increment = 1
+	}
+
+	quotient := new(big.Rat).Quo(new(big.Rat).SetInt(m.bigInt()), new(big.Rat).SetInt(big.NewInt(increment)))
+	units := roundBigRat(quotient, mode)
+	amount := units.Mul(units, big.NewInt(increment))
+
+	result := &Money{currency: m.currency, scale: m.scale, divisor: m.divisor}
+	if amount.IsInt64() {
+		result.amount = amount.Int64()
+	} else {
+		result.big = amount
+	}
+	return result
+}
+
 // Parse creates a Money instance from a string representation
 func Parse(s string, currency Currency, mode RoundingMode) (*Money, error) {
 	// Remove currency symbol, thousands separators, and normalize decimal separator
@@ -231,30 +578,28 @@ func Parse(s string, currency Currency, mode RoundingMode) (*Money, error) {
 	return New(amount, currency.Code, mode)
 }
 
-// Format returns a formatted string representation using the provided options
+// Format returns a formatted string representation using the provided
+// options. It reads through ExactString, so it renders correctly even when
+// the amount has overflowed into the big.Int backend.
 func (m *Money) Format(opts FormatOptions) string {
 	// Handle negative amounts
+	exact := m.ExactString()
 	sign := ""
-	absAmount := m.amount
-	if m.amount < 0 {
+	if strings.HasPrefix(exact, "-") {
 		sign = "-"
-		absAmount = -m.amount
+		exact = exact[1:]
 	}
 
-	// Convert to decimal string with proper scale
-	value := strconv.FormatInt(absAmount, 10)
+	// Split into integer and fractional parts
 	scale := m.scale
-
-	// Pad with leading zeros if necessary
-	for len(value) <= scale {
-		value = "0" + value
+	intPart := exact
+	fracPart := ""
+	if scale > 0 {
+		dot := strings.IndexByte(exact, '.')
+		intPart = exact[:dot]
+		fracPart = exact[dot+1:]
 	}
 
-	// Insert decimal point
-	decimalPos := len(value) - scale
-	intPart := value[:decimalPos]
-	fracPart := value[decimalPos:]
-
 	// Add thousands separators
 	if opts.ThousandsSep != "" {
 		var result []string
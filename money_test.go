@@ -1,9 +1,13 @@
 package money
 
 import (
+	"errors"
 	"math"
+	"math/big"
 	"reflect"
 	"testing"
+
+	"github.com/Ceesaxp/money/format"
 )
 
 func TestNew(t *testing.T) {
@@ -75,10 +79,21 @@ func TestRounding(t *testing.T) {
 		{"round half up 1.4", 1.4, RoundHalfUp, "USD", 140},
 		{"round half down 1.5", 1.5, RoundHalfDown, "USD", 150},
 		{"round half down 1.6", 1.6, RoundHalfDown, "USD", 160},
-		{"round up 1.1", 1.1, RoundUp, "USD", 111}, // 1.1 * 100 = 110, but float is 110.00000000000001 -> 111 after ceiling rounding
-		{"round down 1.9", 1.9, RoundDown, "USD", 190},
+		{"round up 1.1", 1.1, RoundUp, "USD", 110},     // amount is snapped to the decimal "1.1" before scaling, so this is exact, not 111
+		{"round down 1.9", 1.9, RoundDown, "USD", 190}, // likewise exact at "1.9", not 189
 		{"round half even 1.5", 1.5, RoundHalfEven, "USD", 150},
 		{"round half even 2.5", 2.5, RoundHalfEven, "USD", 250},
+		{"round ceiling +1.1", 1.1, RoundCeiling, "USD", 110},
+		{"round ceiling -1.1", -1.1, RoundCeiling, "USD", -110},
+		{"round floor +1.9", 1.9, RoundFloor, "USD", 190},
+		{"round floor -1.9", -1.9, RoundFloor, "USD", -190},
+		{"round up away from zero -1.1", -1.1, RoundUp, "USD", -110},
+		{"round down toward zero -1.9", -1.9, RoundDown, "USD", -190},
+		{"round 05up truncated ends in 0", 1.001, Round05Up, "USD", 101},
+		{"round 05up truncated ends in 5", 0.551, Round05Up, "USD", 56},
+		{"round 05up truncated ends in 4", 0.441, Round05Up, "USD", 44},
+		{"round down 100.10", 100.10, RoundDown, "USD", 10010},
+		{"round up 9.99", 9.99, RoundUp, "USD", 999},
 	}
 
 	for _, tt := range tests {
@@ -95,6 +110,69 @@ func TestRounding(t *testing.T) {
 	}
 }
 
+// TestRoundBigRat_HalfModes exercises tie-breaking directly against an exact
+// big.Rat half (5/2), for both signs, since a float64 literal like 2.5 can
+// land exactly on an integer at currency scale and never actually exercise
+// the tie-breaking branch of roundBigRat.
+func TestRoundBigRat_HalfModes(t *testing.T) {
+	tests := []struct {
+		name string
+		r    *big.Rat
+		mode RoundingMode
+		want int64
+	}{
+		{"half up +2.5", big.NewRat(5, 2), RoundHalfUp, 3},
+		{"half up -2.5", big.NewRat(-5, 2), RoundHalfUp, -2},
+		{"half down +2.5", big.NewRat(5, 2), RoundHalfDown, 2},
+		{"half down -2.5", big.NewRat(-5, 2), RoundHalfDown, -3},
+		{"half toward zero +2.5", big.NewRat(5, 2), RoundHalfTowardZero, 2},
+		{"half toward zero -2.5", big.NewRat(-5, 2), RoundHalfTowardZero, -2},
+		{"half away from zero +2.5", big.NewRat(5, 2), RoundHalfAwayFromZero, 3},
+		{"half away from zero -2.5", big.NewRat(-5, 2), RoundHalfAwayFromZero, -3},
+		{"half even +2.5", big.NewRat(5, 2), RoundHalfEven, 2},
+		{"half even -2.5", big.NewRat(-5, 2), RoundHalfEven, -2},
+		{"half even +3.5", big.NewRat(7, 2), RoundHalfEven, 4},
+		{"half odd +2.5", big.NewRat(5, 2), RoundHalfOdd, 3},
+		{"half odd -2.5", big.NewRat(-5, 2), RoundHalfOdd, -3},
+		{"half odd +3.5", big.NewRat(7, 2), RoundHalfOdd, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := roundBigRat(tt.r, tt.mode).Int64()
+			if got != tt.want {
+				t.Errorf("roundBigRat(%v, %v) = %v, want %v", tt.r, tt.mode, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoney_RoundToIncrement(t *testing.T) {
+	tests := []struct {
+		name      string
+		amount    int64
+		increment int64
+		mode      RoundingMode
+		want      int64
+	}{
+		{"swiss 5-rappen rounds down", 122, 5, RoundHalfEven, 120},
+		{"swiss 5-rappen rounds up", 123, 5, RoundHalfEven, 125},
+		{"10-rappen tie rounds to even multiple (12.5 -> 12)", 125, 10, RoundHalfEven, 120},
+		{"10-rappen tie rounds to even multiple (13.5 -> 14)", 135, 10, RoundHalfEven, 140},
+		{"non-positive increment is a no-op", 123, 0, RoundHalfEven, 123},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, _ := NewFromSmallestUnit(tt.amount, "CHF")
+			got := m.RoundToIncrement(tt.increment, tt.mode)
+			if got.SmallestUnit() != tt.want {
+				t.Errorf("RoundToIncrement(%v, %v) = %v, want %v", tt.increment, tt.mode, got.SmallestUnit(), tt.want)
+			}
+		})
+	}
+}
+
 func TestMoney_Add(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -215,6 +293,16 @@ func TestMoney_Format(t *testing.T) {
 			},
 			want: "-$ 1,234.56 USD", // negative sign is part of the symbol
 		},
+		{
+			name:  "big.Int-backed amount",
+			money: &Money{big: new(big.Int).Lsh(big.NewInt(1), 63), currency: "USD", scale: 2},
+			opts: FormatOptions{
+				Symbol:      "$",
+				DecimalSep:  ".",
+				SymbolFirst: true,
+			},
+			want: "$92233720368547758.08", // must read through bigInt()/ExactString(), not the zero-valued m.amount
+		},
 	}
 
 	for _, tt := range tests {
@@ -277,3 +365,230 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestMoney_AddOverflowsToBigInt(t *testing.T) {
+	huge, err := NewFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 63), big.NewInt(1)), "USD")
+	if err != nil {
+		t.Fatalf("NewFromBigInt() error = %v", err)
+	}
+	one, err := NewFromSmallestUnit(1, "USD")
+	if err != nil {
+		t.Fatalf("NewFromSmallestUnit() error = %v", err)
+	}
+
+	got, err := huge.Add(one)
+	if err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	want := new(big.Int).Lsh(big.NewInt(1), 63)
+	if got.BigInt().Cmp(want) != 0 {
+		t.Errorf("Add() = %v, want %v", got.BigInt(), want)
+	}
+	if got.ExactString() != "92233720368547758.08" {
+		t.Errorf("ExactString() = %v, want 92233720368547758.08", got.ExactString())
+	}
+}
+
+func TestMoney_NewFromString(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string // ExactString()
+		wantErr error
+	}{
+		{name: "simple decimal", input: "1234.56", want: "1234.56"},
+		{name: "negative decimal", input: "-0.01", want: "-0.01"},
+		{name: "integer", input: "42", want: "42.00"},
+		{name: "malformed", input: "not-a-number", wantErr: ErrParseAmount},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewFromString(tt.input, "USD", RoundHalfUp)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("NewFromString() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewFromString() unexpected error = %v", err)
+			}
+			if got.ExactString() != tt.want {
+				t.Errorf("ExactString() = %v, want %v", got.ExactString(), tt.want)
+			}
+		})
+	}
+}
+
+func TestMoney_MultiplyRat(t *testing.T) {
+	m, err := NewFromSmallestUnit(100, "USD") // $1.00
+	if err != nil {
+		t.Fatalf("NewFromSmallestUnit() error = %v", err)
+	}
+
+	got, err := m.MultiplyRat(big.NewRat(1, 3), RoundHalfUp)
+	if err != nil {
+		t.Fatalf("MultiplyRat() error = %v", err)
+	}
+	if got.SmallestUnit() != 33 {
+		t.Errorf("MultiplyRat() = %v, want 33", got.SmallestUnit())
+	}
+}
+
+func TestMoney_Divide_HalfTiesAwayFromZero(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount int64
+		want   int64
+	}{
+		{"positive half rounds up", 1, 1},
+		{"negative half rounds down", -1, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, _ := NewFromSmallestUnit(tt.amount, "USD")
+			got, err := m.Divide(2)
+			if err != nil {
+				t.Fatalf("Divide() error = %v", err)
+			}
+			if got.SmallestUnit() != tt.want {
+				t.Errorf("Divide() = %v, want %v", got.SmallestUnit(), tt.want)
+			}
+		})
+	}
+}
+
+func TestMoney_Allocate(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  int64
+		ratios  []int64
+		want    []int64
+		wantErr error
+	}{
+		{
+			name:   "70/20/10 invoice split",
+			amount: 100,
+			ratios: []int64{70, 20, 10},
+			want:   []int64{70, 20, 10},
+		},
+		{
+			name:   "remainder goes to earliest ratios",
+			amount: 100,
+			ratios: []int64{1, 1, 1},
+			want:   []int64{34, 33, 33},
+		},
+		{
+			name:    "empty ratios",
+			amount:  100,
+			ratios:  []int64{},
+			wantErr: ErrInvalidAllocation,
+		},
+		{
+			name:    "negative ratio",
+			amount:  100,
+			ratios:  []int64{1, -1},
+			wantErr: ErrInvalidAllocation,
+		},
+		{
+			name:    "zero total",
+			amount:  100,
+			ratios:  []int64{0, 0},
+			wantErr: ErrInvalidAllocation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, _ := NewFromSmallestUnit(tt.amount, "USD")
+			got, err := m.Allocate(tt.ratios)
+			if err != tt.wantErr {
+				t.Errorf("Allocate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+
+			amounts := make([]int64, len(got))
+			sum := int64(0)
+			for i, money := range got {
+				amounts[i] = money.SmallestUnit()
+				sum += money.SmallestUnit()
+			}
+			if !reflect.DeepEqual(amounts, tt.want) {
+				t.Errorf("Allocate() = %v, want %v", amounts, tt.want)
+			}
+			if sum != tt.amount {
+				t.Errorf("Allocate() sum = %v, want %v", sum, tt.amount)
+			}
+		})
+	}
+}
+
+func TestMoney_AllocateFloat(t *testing.T) {
+	m, _ := NewFromSmallestUnit(100, "USD")
+	got, err := m.AllocateFloat([]float64{0.7, 0.2, 0.1})
+	if err != nil {
+		t.Fatalf("AllocateFloat() error = %v", err)
+	}
+
+	want := []int64{70, 20, 10}
+	sum := int64(0)
+	for i, money := range got {
+		if money.SmallestUnit() != want[i] {
+			t.Errorf("AllocateFloat()[%d] = %v, want %v", i, money.SmallestUnit(), want[i])
+		}
+		sum += money.SmallestUnit()
+	}
+	if sum != 100 {
+		t.Errorf("AllocateFloat() sum = %v, want 100", sum)
+	}
+}
+
+func TestMoney_FormatLocale(t *testing.T) {
+	m, err := NewFromSmallestUnit(123456, "USD")
+	if err != nil {
+		t.Fatalf("NewFromSmallestUnit() error = %v", err)
+	}
+
+	usLocale, _ := format.Lookup("en-US")
+	got, err := m.FormatLocale(usLocale, "¤#,##0.00")
+	if err != nil {
+		t.Fatalf("FormatLocale() error = %v", err)
+	}
+	if want := "$1,234.56"; got != want {
+		t.Errorf("FormatLocale() = %q, want %q", got, want)
+	}
+
+	roundTripped, err := ParseLocale(got, "USD", usLocale, "¤#,##0.00")
+	if err != nil {
+		t.Fatalf("ParseLocale() error = %v", err)
+	}
+	if roundTripped.SmallestUnit() != m.SmallestUnit() {
+		t.Errorf("ParseLocale() = %v, want %v", roundTripped.SmallestUnit(), m.SmallestUnit())
+	}
+}
+
+func TestMoney_Split_ExactSum(t *testing.T) {
+	huge, err := NewFromBigInt(new(big.Int).Lsh(big.NewInt(1), 64), "USD")
+	if err != nil {
+		t.Fatalf("NewFromBigInt() error = %v", err)
+	}
+
+	parts, err := huge.Split(3)
+	if err != nil {
+		t.Fatalf("Split() error = %v", err)
+	}
+
+	sum := new(big.Int)
+	for _, p := range parts {
+		sum.Add(sum, p.BigInt())
+	}
+	if sum.Cmp(huge.BigInt()) != 0 {
+		t.Errorf("Split() sum = %v, want %v", sum, huge.BigInt())
+	}
+}